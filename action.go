@@ -0,0 +1,125 @@
+package dataclassifier
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Action describes what should happen to a value once it has been
+// classified within a particular scope, such as "logs", "metrics",
+// "traces" or "audit". Values are declared least to most restrictive -
+// Enforcer.lookup relies on this ordering to resolve overlapping
+// bindings, so Redact (destroys the value entirely) outranks Hash
+// (preserves a comparable, irreversible fingerprint of it).
+type Action int
+
+const (
+	Allow Action = iota
+	Warn
+	Hash
+	Redact
+	Drop
+	Deny
+)
+
+var actionstr = map[Action]string{
+	Allow:  "allow",
+	Warn:   "warn",
+	Redact: "redact",
+	Hash:   "hash",
+	Drop:   "drop",
+	Deny:   "deny",
+}
+
+func (a Action) String() string {
+	if s, ok := actionstr[a]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// Enforcer holds a table of scoped Classification -> Action bindings and
+// applies them to values. The same Classification can be bound to
+// different Action per scope, so a value tagged UGC|PII can, for example,
+// be dropped in the "logs" scope but hashed in the "metrics" scope.
+type Enforcer struct {
+	scoped map[string]map[Classification]Action
+}
+
+// NewEnforcer returns an Enforcer with no bindings; every Classification
+// is Allow in every scope until bound.
+func NewEnforcer() *Enforcer {
+	return &Enforcer{scoped: make(map[string]map[Classification]Action)}
+}
+
+// Bind registers the Action to take for values classified as c within
+// scope. A binding for a single flag also applies to combined values that
+// carry that flag; see lookup.
+func (e *Enforcer) Bind(c Classification, scope string, action Action) {
+	m, ok := e.scoped[scope]
+	if !ok {
+		m = make(map[Classification]Action)
+		e.scoped[scope] = m
+	}
+	m[c] = action
+}
+
+// Enforce returns value transformed per the Action bound to c within
+// scope, alongside that Action. A Drop or Deny Action returns an empty
+// string, a Redact Action returns a fixed placeholder, and a Hash Action
+// returns a hex-encoded SHA-256 digest of value. With no binding, value is
+// returned unchanged under Allow.
+func (e *Enforcer) Enforce(c Classification, scope string, value string) (string, Action) {
+	action := e.lookup(c, scope)
+	switch action {
+	case Drop, Deny:
+		return "", action
+	case Redact:
+		return "[REDACTED]", action
+	case Hash:
+		sum := sha256.Sum256([]byte(value))
+		return hex.EncodeToString(sum[:]), action
+	default:
+		return value, action
+	}
+}
+
+// lookup resolves the Action bound for c within scope: the most
+// restrictive of the exact binding for the combined value (if any) and the
+// bindings for each of c's constituent Classification flags (Action's
+// values are already ordered least to most restrictive, so the largest
+// wins). This means a value tagged UGC|PII is still dropped under a scope
+// that binds PII to Drop, even if UGC|PII itself was never explicitly
+// bound. Allow is returned when nothing is bound.
+func (e *Enforcer) lookup(c Classification, scope string) Action {
+	m, ok := e.scoped[scope]
+	if !ok {
+		return Allow
+	}
+	best := Allow
+	if action, ok := m[c]; ok {
+		best = action
+	}
+	for _, bit := range c.Bits() {
+		if action, ok := m[bit]; ok && action > best {
+			best = action
+		}
+	}
+	return best
+}
+
+// defaultEnforcer is the package-level Enforcer used by Bind and Enforce,
+// for callers that don't need an isolated binding table.
+var defaultEnforcer = NewEnforcer()
+
+// Bind registers the Action to take for values classified as c within
+// scope, on the package-level Enforcer. See Enforcer.Bind.
+func Bind(c Classification, scope string, action Action) {
+	defaultEnforcer.Bind(c, scope, action)
+}
+
+// Enforce applies the package-level Enforcer's bindings to value. See
+// Enforcer.Enforce.
+func Enforce(c Classification, scope string, value string) (string, Action) {
+	return defaultEnforcer.Enforce(c, scope, value)
+}
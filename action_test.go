@@ -0,0 +1,85 @@
+package dataclassifier_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dataclassifier "github.com/MovieStoreGuy/data-classifier"
+)
+
+func TestEnforcerScopesBindingsIndependently(t *testing.T) {
+	t.Parallel()
+
+	tagged := dataclassifier.Combine(dataclassifier.UGC, dataclassifier.PII)
+
+	e := dataclassifier.NewEnforcer()
+	e.Bind(tagged, "logs", dataclassifier.Drop)
+	e.Bind(tagged, "metrics", dataclassifier.Hash)
+
+	value, action := e.Enforce(tagged, "logs", "pineapples belong on pizza")
+	assert.Equal(t, dataclassifier.Drop, action)
+	assert.Empty(t, value)
+
+	value, action = e.Enforce(tagged, "metrics", "pineapples belong on pizza")
+	assert.Equal(t, dataclassifier.Hash, action)
+	assert.NotEmpty(t, value)
+	assert.NotEqual(t, "pineapples belong on pizza", value)
+}
+
+func TestEnforcerDefaultsToAllow(t *testing.T) {
+	t.Parallel()
+
+	e := dataclassifier.NewEnforcer()
+	value, action := e.Enforce(dataclassifier.PII, "audit", "unbound value")
+
+	assert.Equal(t, dataclassifier.Allow, action)
+	assert.Equal(t, "unbound value", value)
+}
+
+func TestEnforcerResolvesOverlappingBitsToMostRestrictiveAction(t *testing.T) {
+	t.Parallel()
+
+	e := dataclassifier.NewEnforcer()
+	e.Bind(dataclassifier.PII, "logs", dataclassifier.Drop)
+	e.Bind(dataclassifier.UGC, "logs", dataclassifier.Redact)
+
+	tagged := dataclassifier.Combine(dataclassifier.UGC, dataclassifier.PII)
+	value, action := e.Enforce(tagged, "logs", "pineapples belong on pizza")
+	assert.Equal(t, dataclassifier.Drop, action)
+	assert.Empty(t, value)
+}
+
+func TestEnforcerPrefersRedactOverHash(t *testing.T) {
+	t.Parallel()
+
+	e := dataclassifier.NewEnforcer()
+	e.Bind(dataclassifier.PII, "logs", dataclassifier.Hash)
+	e.Bind(dataclassifier.Sensitive, "logs", dataclassifier.Redact)
+
+	tagged := dataclassifier.Combine(dataclassifier.PII, dataclassifier.Sensitive)
+	value, action := e.Enforce(tagged, "logs", "123-45-6789")
+	assert.Equal(t, dataclassifier.Redact, action)
+	assert.Equal(t, "[REDACTED]", value)
+}
+
+func TestEnforceRedactsBoundValue(t *testing.T) {
+	t.Parallel()
+
+	e := dataclassifier.NewEnforcer()
+	e.Bind(dataclassifier.Sensitive, "traces", dataclassifier.Redact)
+
+	value, action := e.Enforce(dataclassifier.Sensitive, "traces", "Bearer token")
+	assert.Equal(t, dataclassifier.Redact, action)
+	assert.Equal(t, "[REDACTED]", value)
+}
+
+func TestPackageLevelBindAndEnforce(t *testing.T) {
+	// Exercises the shared, package-level Enforcer, so this test must not
+	// run in parallel with others that touch it.
+	dataclassifier.Bind(dataclassifier.HighCardinality, "audit", dataclassifier.Warn)
+
+	value, action := dataclassifier.Enforce(dataclassifier.HighCardinality, "audit", "2026-07-29T00:00:00Z")
+	assert.Equal(t, dataclassifier.Warn, action)
+	assert.Equal(t, "2026-07-29T00:00:00Z", value)
+}
@@ -1,6 +1,10 @@
 package dataclassifier
 
-import "strings"
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
 
 type Classification int64
 
@@ -29,6 +33,31 @@ var enumstr = map[Classification]string{
 	ServiceLevelObject: "service-level-objective",
 }
 
+// bits lists every individual flag Classification can be composed of, in
+// ascending order, so a combined value can be decomposed deterministically.
+var bits = []Classification{
+	Persist,
+	UGC,
+	PD,
+	Sensitive,
+	HighCardinality,
+	ServiceLevelObject,
+}
+
+// strToClassification is the reverse of enumstr, plus the short-hand
+// aliases (ugc, pii, pd) that already exist as Go identifiers in this
+// package, so ParseClassification can accept either form.
+var strToClassification = func() map[string]Classification {
+	m := make(map[string]Classification, len(enumstr)+2)
+	for c, s := range enumstr {
+		m[s] = c
+	}
+	m["ugc"] = UGC
+	m["pii"] = PII
+	m["pd"] = PD
+	return m
+}()
+
 func Combine(values ...Classification) (value Classification) {
 	for _, v := range values {
 		value |= v
@@ -40,20 +69,105 @@ func (c Classification) Contains(value Classification) bool {
 	return c == value || (c&value > 0)
 }
 
+// Bits returns the individual flags set in c, in the same ascending order
+// as String, so a combined Classification can be resolved against
+// per-flag state (such as a binding keyed by a single flag) rather than
+// only against the exact combined value.
+func (c Classification) Bits() []Classification {
+	set := make([]Classification, 0, len(bits))
+	for _, b := range bits {
+		if c&b != 0 {
+			set = append(set, b)
+		}
+	}
+	return set
+}
+
 func (c Classification) Remove(value Classification) Classification {
 	return c ^ value
 }
 
+// String returns cf as a stable, comma-separated list of canonical tokens,
+// e.g. "user-generated-content,sensitive". The result round-trips through
+// ParseClassification.
 func (cf Classification) String() string {
-	var sb strings.Builder
-	for i, c := 0, Classification(0); i < len(enumstr); i, c = i+1, 1<<i {
-		if !cf.Contains(c) {
-			continue
+	if cf == NoValue {
+		return enumstr[NoValue]
+	}
+	parts := make([]string, 0, len(bits))
+	for _, c := range bits {
+		if cf.Contains(c) {
+			parts = append(parts, enumstr[c])
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseClassification parses a comma-separated list of classification
+// tokens, accepting both the canonical token (as produced by String) and
+// the short-hand aliases (ugc, pii, pd) already defined in this package,
+// case-insensitively. An empty string parses as NoValue.
+func ParseClassification(s string) (Classification, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == enumstr[NoValue] {
+		return NoValue, nil
+	}
+	var result Classification
+	for _, token := range strings.Split(s, ",") {
+		token = strings.ToLower(strings.TrimSpace(token))
+		c, ok := strToClassification[token]
+		if !ok {
+			return NoValue, fmt.Errorf("dataclassifier: unknown classification %q", token)
 		}
-		sb.WriteString(enumstr[c])
-		if i < len(enumstr)-1 {
-			sb.WriteRune(',')
+		result = Combine(result, c)
+	}
+	return result, nil
+}
+
+// MarshalJSON encodes cf as an array of canonical string tokens in the
+// same order as String, e.g.
+// ["user-generated-content","personal-identifiable-information"], rather
+// than the underlying bitmask integer.
+func (cf Classification) MarshalJSON() ([]byte, error) {
+	if cf == NoValue {
+		return json.Marshal([]string{})
+	}
+	tokens := make([]string, 0, len(bits))
+	for _, c := range bits {
+		if cf.Contains(c) {
+			tokens = append(tokens, enumstr[c])
 		}
 	}
-	return sb.String()
+	return json.Marshal(tokens)
+}
+
+// UnmarshalJSON decodes an array of canonical string tokens produced by
+// MarshalJSON back into a Classification.
+func (cf *Classification) UnmarshalJSON(data []byte) error {
+	var tokens []string
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return err
+	}
+	c, err := ParseClassification(strings.Join(tokens, ","))
+	if err != nil {
+		return err
+	}
+	*cf = c
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same
+// comma-separated form as String.
+func (cf Classification) MarshalText() ([]byte, error) {
+	return []byte(cf.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler via ParseClassification.
+func (cf *Classification) UnmarshalText(data []byte) error {
+	c, err := ParseClassification(string(data))
+	if err != nil {
+		return err
+	}
+	*cf = c
+	return nil
 }
@@ -1,6 +1,7 @@
 package dataclassifier_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"regexp"
@@ -147,6 +148,70 @@ func TestMatchMasks(t *testing.T) {
 	}
 }
 
+func TestClassificationBits(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, dataclassifier.NoValue.Bits())
+	assert.Equal(t, []dataclassifier.Classification{dataclassifier.PII}, dataclassifier.PII.Bits())
+
+	combined := dataclassifier.Combine(dataclassifier.Sensitive, dataclassifier.UGC)
+	assert.Equal(t, []dataclassifier.Classification{dataclassifier.UGC, dataclassifier.Sensitive}, combined.Bits())
+}
+
+func TestStringRoundTripsThroughParseClassification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []dataclassifier.Classification{
+		dataclassifier.NoValue,
+		dataclassifier.UGC,
+		dataclassifier.Combine(dataclassifier.UGC, dataclassifier.PII),
+		dataclassifier.Combine(dataclassifier.Sensitive, dataclassifier.HighCardinality, dataclassifier.ServiceLevelObject),
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.String(), func(t *testing.T) {
+			got, err := dataclassifier.ParseClassification(tc.String())
+			require.NoError(t, err)
+			assert.Equal(t, tc, got)
+		})
+	}
+}
+
+func TestStringHasNoTrailingComma(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "user-generated-content", dataclassifier.UGC.String())
+}
+
+func TestParseClassificationAcceptsAliases(t *testing.T) {
+	t.Parallel()
+
+	got, err := dataclassifier.ParseClassification("PII,UGC")
+	require.NoError(t, err)
+	assert.Equal(t, dataclassifier.Combine(dataclassifier.PII, dataclassifier.UGC), got)
+}
+
+func TestParseClassificationRejectsUnknownToken(t *testing.T) {
+	t.Parallel()
+
+	_, err := dataclassifier.ParseClassification("not-a-real-classification")
+	assert.Error(t, err)
+}
+
+func TestClassificationJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	want := dataclassifier.Combine(dataclassifier.PII, dataclassifier.UGC)
+
+	data, err := json.Marshal(want)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["user-generated-content","personal-identifiable-information"]`, string(data))
+
+	var got dataclassifier.Classification
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, want, got)
+}
+
 var (
 	// cached is used to ensure the result from the for loop
 	// is not compile time optimised out
@@ -0,0 +1,208 @@
+package detect
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	dataclassifier "github.com/MovieStoreGuy/data-classifier"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`^[\w.+-]+@[\w-]+\.[a-zA-Z]{2,}$`)
+
+	// nanpPhonePattern matches a North American Numbering Plan number: an
+	// optional country code, a three digit area code that cannot start with
+	// 0 or 1, then a 3-3-4 digit grouping. This deliberately rejects
+	// ISO 8601 dates (e.g. "2026-07-29") and arbitrary dash-separated
+	// numeric IDs, which share the loose "digits and separators" shape but
+	// not this grouping.
+	nanpPhonePattern = regexp.MustCompile(`^(\+?1[\s.-]?)?\(?[2-9]\d{2}\)?[\s.-]?\d{3}[\s.-]?\d{4}$`)
+	// intlPhonePattern matches an E.164-style international number: a
+	// mandatory "+" and country code followed by 2-4 digit groups.
+	intlPhonePattern = regexp.MustCompile(`^\+[1-9]\d{0,2}[\s.-]?\d{2,4}([\s.-]?\d{2,4}){1,3}$`)
+
+	// jwtPattern requires each dot-separated segment to be at least 10
+	// base64url characters, the shortest a real JWT header/payload segment
+	// ever is, so short dotted strings like version numbers ("go1.21.6")
+	// and hostnames ("example.com.au") don't match on shape alone.
+	jwtPattern  = regexp.MustCompile(`^[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}$`)
+	uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+	timestampLayouts = []string{
+		time.RFC3339,
+		time.RFC3339Nano,
+		time.RFC1123,
+		"2006-01-02 15:04:05",
+	}
+)
+
+// Email flags values that look like an email address as dataclassifier.PII.
+var Email Detector = DetectorFunc(func(_, value string) dataclassifier.Classification {
+	if emailPattern.MatchString(value) {
+		return dataclassifier.PII
+	}
+	return dataclassifier.NoValue
+})
+
+// Phone flags values that look like a phone number as dataclassifier.PII.
+var Phone Detector = DetectorFunc(func(_, value string) dataclassifier.Classification {
+	if nanpPhonePattern.MatchString(value) || intlPhonePattern.MatchString(value) {
+		return dataclassifier.PII
+	}
+	return dataclassifier.NoValue
+})
+
+// CreditCard flags values that pass the Luhn checksum as
+// dataclassifier.Sensitive.
+var CreditCard Detector = DetectorFunc(func(_, value string) dataclassifier.Classification {
+	if luhnValid(value) {
+		return dataclassifier.Sensitive
+	}
+	return dataclassifier.NoValue
+})
+
+func luhnValid(value string) bool {
+	var digits []int
+	for _, r := range value {
+		switch {
+		case unicode.IsDigit(r):
+			digits = append(digits, int(r-'0'))
+		case r == ' ' || r == '-':
+			continue
+		default:
+			return false
+		}
+	}
+	if len(digits) < 12 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := digits[i]
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// IP flags values that parse as an IPv4 or IPv6 address as
+// dataclassifier.HighCardinality.
+var IP Detector = DetectorFunc(func(_, value string) dataclassifier.Classification {
+	if net.ParseIP(value) != nil {
+		return dataclassifier.HighCardinality
+	}
+	return dataclassifier.NoValue
+})
+
+// JWT flags values shaped like a three-part base64url JSON Web Token,
+// whose first segment decodes to a JSON header carrying an "alg" field,
+// as dataclassifier.Sensitive.
+var JWT Detector = DetectorFunc(func(_, value string) dataclassifier.Classification {
+	if jwtPattern.MatchString(value) && hasJWTHeader(value) {
+		return dataclassifier.Sensitive
+	}
+	return dataclassifier.NoValue
+})
+
+// hasJWTHeader reports whether value's first dot-separated segment
+// base64url-decodes to a JSON object with a non-empty "alg" field, as a
+// real JWT header does. This rules out version strings and hostnames that
+// happen to match jwtPattern's shape but aren't base64url-encoded JSON at
+// all.
+func hasJWTHeader(value string) bool {
+	header := value[:strings.IndexByte(value, '.')]
+	decoded, err := base64.RawURLEncoding.DecodeString(header)
+	if err != nil {
+		return false
+	}
+	var claims struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(decoded, &claims); err != nil {
+		return false
+	}
+	return claims.Alg != ""
+}
+
+// Timestamp flags values that parse under common time layouts as
+// dataclassifier.HighCardinality.
+var Timestamp Detector = DetectorFunc(func(_, value string) dataclassifier.Classification {
+	for _, layout := range timestampLayouts {
+		if _, err := time.Parse(layout, value); err == nil {
+			return dataclassifier.HighCardinality
+		}
+	}
+	return dataclassifier.NoValue
+})
+
+// UUID flags values shaped like a UUID as dataclassifier.HighCardinality.
+var UUID Detector = DetectorFunc(func(_, value string) dataclassifier.Classification {
+	if uuidPattern.MatchString(value) {
+		return dataclassifier.HighCardinality
+	}
+	return dataclassifier.NoValue
+})
+
+// HighEntropy flags long values with a high ratio of distinct characters as
+// dataclassifier.HighCardinality, catching opaque tokens and identifiers
+// that don't match a more specific pattern.
+var HighEntropy Detector = DetectorFunc(func(_, value string) dataclassifier.Classification {
+	if len(value) < 16 {
+		return dataclassifier.NoValue
+	}
+	seen := make(map[rune]struct{}, len(value))
+	for _, r := range value {
+		seen[r] = struct{}{}
+	}
+	if float64(len(seen))/float64(len(value)) >= 0.6 {
+		return dataclassifier.HighCardinality
+	}
+	return dataclassifier.NoValue
+})
+
+// UGC flags long, prose-like free text as dataclassifier.UGC based on
+// length and punctuation ratio, rather than structured fields such as
+// identifiers or enums.
+var UGC Detector = DetectorFunc(func(_, value string) dataclassifier.Classification {
+	if len(value) < 20 || strings.Count(value, " ") < 2 {
+		return dataclassifier.NoValue
+	}
+	punct := 0
+	for _, r := range value {
+		if unicode.IsPunct(r) {
+			punct++
+		}
+	}
+	if ratio := float64(punct) / float64(len(value)); ratio <= 0.3 {
+		return dataclassifier.UGC
+	}
+	return dataclassifier.NoValue
+})
+
+// Defaults returns the built-in detectors recommended for general purpose
+// use, in the order they are evaluated by a Pipeline.
+func Defaults() []Detector {
+	return []Detector{
+		Email,
+		Phone,
+		CreditCard,
+		IP,
+		JWT,
+		UUID,
+		Timestamp,
+		HighEntropy,
+		UGC,
+	}
+}
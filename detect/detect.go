@@ -0,0 +1,48 @@
+// Package detect provides pluggable detectors that inspect attribute
+// name/value pairs and infer a dataclassifier.Classification automatically,
+// rather than requiring callers to hard-code a Classification per field.
+package detect
+
+import dataclassifier "github.com/MovieStoreGuy/data-classifier"
+
+// Detector inspects a single name/value pair and returns the Classification
+// it believes applies. A Detector that does not recognise the input should
+// return dataclassifier.NoValue.
+type Detector interface {
+	Classify(name, value string) dataclassifier.Classification
+}
+
+// DetectorFunc allows a plain function to satisfy Detector.
+type DetectorFunc func(name, value string) dataclassifier.Classification
+
+func (fn DetectorFunc) Classify(name, value string) dataclassifier.Classification {
+	return fn(name, value)
+}
+
+// Pipeline runs a configured chain of Detector over a name/value pair and
+// OR-combines (dataclassifier.Combine) every match into a single
+// Classification.
+type Pipeline struct {
+	detectors []Detector
+}
+
+// NewPipeline constructs a Pipeline that runs each of the given detectors,
+// in order, against every Classify call.
+func NewPipeline(detectors ...Detector) *Pipeline {
+	return &Pipeline{detectors: detectors}
+}
+
+// Add appends additional detectors to the pipeline.
+func (p *Pipeline) Add(detectors ...Detector) {
+	p.detectors = append(p.detectors, detectors...)
+}
+
+// Classify runs name/value through every configured detector and returns
+// the combined Classification.
+func (p *Pipeline) Classify(name, value string) dataclassifier.Classification {
+	result := dataclassifier.NoValue
+	for _, d := range p.detectors {
+		result = dataclassifier.Combine(result, d.Classify(name, value))
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+// Package otelclassifier applies dataclassifier rules to OpenTelemetry
+// span, log, and resource attributes - the Resource/Filter shape already
+// exercised in this module's benchmarks is a direct analogue of OTEL's
+// resource/attributes model. A Processor redacts, hashes, or drops
+// attribute values per a policy.Policy and stamps the combined
+// dataclassifier.Classification onto the owning resource for downstream
+// sinks to act on.
+package otelclassifier
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	dataclassifier "github.com/MovieStoreGuy/data-classifier"
+	"github.com/MovieStoreGuy/data-classifier/policy"
+)
+
+// ClassificationAttribute is the resource attribute key a Processor
+// stamps with the combined Classification of every attribute it inspects.
+const ClassificationAttribute = "dataclassifier.classification"
+
+// Processor applies a policy.Policy to OpenTelemetry attribute maps.
+type Processor struct {
+	policy *policy.Policy
+}
+
+// New returns a Processor that applies p to every attribute map it is
+// given.
+func New(p *policy.Policy) *Processor {
+	return &Processor{policy: p}
+}
+
+// ProcessAttributes walks attrs, replacing each value with the
+// policy-applied value (or removing the attribute entirely, for a
+// dropping Action) and returns the combined Classification of every
+// attribute seen. An attribute whose Action is ActionPersist is left
+// untouched rather than round-tripped through SetStr, so non-string
+// values (int64, bool, double, ...) aren't coerced to a string type when
+// nothing matched a rule.
+func (proc *Processor) ProcessAttributes(attrs pcommon.Map) dataclassifier.Classification {
+	combined := dataclassifier.NoValue
+
+	var drop []string
+	attrs.Range(func(name string, value pcommon.Value) bool {
+		newValue, c, action := proc.policy.Apply(name, value.AsString())
+		combined = dataclassifier.Combine(combined, c)
+		switch action {
+		case policy.ActionDrop:
+			drop = append(drop, name)
+		case policy.ActionPersist:
+		default:
+			value.SetStr(newValue)
+		}
+		return true
+	})
+
+	for _, name := range drop {
+		attrs.Remove(name)
+	}
+
+	return combined
+}
+
+// ProcessResource applies ProcessAttributes to a resource's own attributes
+// and any number of scoped attribute maps belonging to it (spans, log
+// records), then stamps the combined Classification onto the resource as
+// ClassificationAttribute.
+func (proc *Processor) ProcessResource(resource pcommon.Map, scoped ...pcommon.Map) dataclassifier.Classification {
+	combined := proc.ProcessAttributes(resource)
+	for _, attrs := range scoped {
+		combined = dataclassifier.Combine(combined, proc.ProcessAttributes(attrs))
+	}
+	resource.PutStr(ClassificationAttribute, combined.String())
+	return combined
+}
@@ -0,0 +1,122 @@
+package otelclassifier_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	dataclassifier "github.com/MovieStoreGuy/data-classifier"
+	"github.com/MovieStoreGuy/data-classifier/otelclassifier"
+	"github.com/MovieStoreGuy/data-classifier/policy"
+)
+
+const testPolicy = `{
+	"default": "no-value",
+	"rules": [
+		{"pattern": "user.*", "classification": "pii"},
+		{"pattern": "*.body", "classification": "ugc"}
+	],
+	"actions": {
+		"pii": "hash",
+		"ugc": "drop"
+	}
+}`
+
+func newProcessor(tb testing.TB) *otelclassifier.Processor {
+	tb.Helper()
+
+	p, err := policy.Load(strings.NewReader(testPolicy))
+	require.NoError(tb, err)
+	return otelclassifier.New(p)
+}
+
+func TestProcessAttributes(t *testing.T) {
+	t.Parallel()
+
+	proc := newProcessor(t)
+	attrs := pcommon.NewMap()
+	attrs.PutStr("user.name", "Jane Doe")
+	attrs.PutStr("message.body", "pineapples belong on pizza")
+	attrs.PutStr("timestamp", "2026-07-29T00:00:00Z")
+
+	combined := proc.ProcessAttributes(attrs)
+
+	assert.True(t, combined.Contains(dataclassifier.PII))
+	assert.True(t, combined.Contains(dataclassifier.UGC))
+
+	_, ok := attrs.Get("message.body")
+	assert.False(t, ok, "dropped attribute must be removed")
+
+	name, ok := attrs.Get("user.name")
+	require.True(t, ok)
+	assert.NotEqual(t, "Jane Doe", name.AsString(), "hashed attribute must not keep its original value")
+}
+
+func TestProcessAttributesLeavesUnmatchedNonStringValuesUntouched(t *testing.T) {
+	t.Parallel()
+
+	proc := newProcessor(t)
+	attrs := pcommon.NewMap()
+	attrs.PutInt("retry.count", 42)
+	attrs.PutBool("retry.ok", true)
+	attrs.PutDouble("latency.ms", 12.5)
+
+	proc.ProcessAttributes(attrs)
+
+	count, ok := attrs.Get("retry.count")
+	require.True(t, ok)
+	assert.Equal(t, pcommon.ValueTypeInt, count.Type(), "unmatched int attribute must not be coerced to a string")
+	assert.Equal(t, int64(42), count.Int())
+
+	ok2, ok := attrs.Get("retry.ok")
+	require.True(t, ok)
+	assert.Equal(t, pcommon.ValueTypeBool, ok2.Type(), "unmatched bool attribute must not be coerced to a string")
+	assert.True(t, ok2.Bool())
+
+	latency, ok := attrs.Get("latency.ms")
+	require.True(t, ok)
+	assert.Equal(t, pcommon.ValueTypeDouble, latency.Type(), "unmatched double attribute must not be coerced to a string")
+	assert.Equal(t, 12.5, latency.Double())
+}
+
+func TestProcessResourceStampsCombinedClassification(t *testing.T) {
+	t.Parallel()
+
+	proc := newProcessor(t)
+	resource := pcommon.NewMap()
+	span := pcommon.NewMap()
+	span.PutStr("user.name", "Jane Doe")
+
+	combined := proc.ProcessResource(resource, span)
+	assert.True(t, combined.Contains(dataclassifier.PII))
+
+	stamped, ok := resource.Get(otelclassifier.ClassificationAttribute)
+	require.True(t, ok)
+	assert.Equal(t, combined.String(), stamped.AsString())
+}
+
+var sizes = []int{1, 10, 100, 1_000, 10_000, 100_000, 1_000_000}
+
+// BenchmarkProcessAttributes publishes the processor's performance
+// envelope across 1..1M attributes, mirroring the collection-size
+// benchmarks this module already runs against plain attribute filtering.
+func BenchmarkProcessAttributes(b *testing.B) {
+	proc := newProcessor(b)
+
+	for _, size := range sizes {
+		attrs := pcommon.NewMap()
+		for i := 0; i < size; i++ {
+			attrs.PutStr(fmt.Sprintf("user.field_%d", i), "value")
+		}
+
+		b.Run(fmt.Sprintf("Attributes-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				proc.ProcessAttributes(attrs)
+			}
+		})
+	}
+}
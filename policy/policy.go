@@ -0,0 +1,153 @@
+// Package policy loads a declarative classification policy - which
+// attribute name patterns map to which dataclassifier.Classification, a
+// default Classification for unmatched fields, and which Action to take per
+// classification - from JSON, so classification configuration can ship
+// alongside a service instead of being hard-coded at each
+// dataclassifier.Combine call site.
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dataclassifier "github.com/MovieStoreGuy/data-classifier"
+	"github.com/MovieStoreGuy/data-classifier/rules"
+)
+
+// Action describes what a consumer should do with a value once Policy.Apply
+// has classified it.
+type Action string
+
+const (
+	ActionPersist Action = "persist"
+	ActionDrop    Action = "drop"
+	ActionRedact  Action = "redact"
+	ActionHash    Action = "hash"
+)
+
+var validActions = map[Action]struct{}{
+	ActionPersist: {},
+	ActionDrop:    {},
+	ActionRedact:  {},
+	ActionHash:    {},
+}
+
+// toEnforcerAction and fromEnforcerAction translate between Policy's
+// JSON-facing Action and dataclassifier.Action, so Policy can bind and
+// enforce through the shared dataclassifier.Enforcer instead of
+// reimplementing drop/redact/hash handling and bit-overlap resolution.
+var toEnforcerAction = map[Action]dataclassifier.Action{
+	ActionPersist: dataclassifier.Allow,
+	ActionRedact:  dataclassifier.Redact,
+	ActionHash:    dataclassifier.Hash,
+	ActionDrop:    dataclassifier.Drop,
+}
+
+var fromEnforcerAction = map[dataclassifier.Action]Action{
+	dataclassifier.Allow:  ActionPersist,
+	dataclassifier.Warn:   ActionPersist,
+	dataclassifier.Redact: ActionRedact,
+	dataclassifier.Hash:   ActionHash,
+	dataclassifier.Drop:   ActionDrop,
+	dataclassifier.Deny:   ActionDrop,
+}
+
+// enforcerScope is the single dataclassifier.Enforcer scope a Policy binds
+// its actions under. Policy has no notion of multiple scopes itself; that
+// granularity belongs to the caller composing Policy with other Enforcer
+// bindings.
+const enforcerScope = "policy"
+
+// Rule is the declarative, JSON-serialisable form of a single field rule:
+// Pattern is a glob matched against an attribute name (see package rules)
+// and Classification is a comma-separated list of classification tokens,
+// e.g. "pii,ugc".
+type Rule struct {
+	Pattern        string `json:"pattern"`
+	Classification string `json:"classification"`
+}
+
+// document is the on-disk shape accepted by Load.
+type document struct {
+	Default string            `json:"default"`
+	Rules   []Rule            `json:"rules"`
+	Actions map[string]string `json:"actions"`
+}
+
+// Policy is a compiled classification policy ready to be applied to
+// name/value pairs.
+type Policy struct {
+	set      *rules.Set
+	def      dataclassifier.Classification
+	enforcer *dataclassifier.Enforcer
+}
+
+// Load parses a JSON document and returns a compiled Policy. It validates
+// every rule pattern and classification token, and rejects unknown
+// classification names and actions.
+func Load(r io.Reader) (*Policy, error) {
+	var doc document
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("policy: decode: %w", err)
+	}
+	return fromDocument(doc)
+}
+
+func fromDocument(doc document) (*Policy, error) {
+	p := &Policy{
+		set:      rules.NewSet(),
+		enforcer: dataclassifier.NewEnforcer(),
+	}
+
+	if doc.Default != "" {
+		c, err := dataclassifier.ParseClassification(doc.Default)
+		if err != nil {
+			return nil, fmt.Errorf("policy: default: %w", err)
+		}
+		p.def = c
+	}
+
+	for _, rule := range doc.Rules {
+		c, err := dataclassifier.ParseClassification(rule.Classification)
+		if err != nil {
+			return nil, fmt.Errorf("policy: rule %q: %w", rule.Pattern, err)
+		}
+		if err := p.set.Add(rule.Pattern, c); err != nil {
+			return nil, fmt.Errorf("policy: rule %q: %w", rule.Pattern, err)
+		}
+	}
+
+	for name, action := range doc.Actions {
+		c, err := dataclassifier.ParseClassification(name)
+		if err != nil {
+			return nil, fmt.Errorf("policy: action classification %q: %w", name, err)
+		}
+		a := Action(action)
+		if _, ok := validActions[a]; !ok {
+			return nil, fmt.Errorf("policy: unknown action %q", action)
+		}
+		p.enforcer.Bind(c, enforcerScope, toEnforcerAction[a])
+	}
+
+	return p, nil
+}
+
+// Apply classifies name/value according to the policy and returns the
+// value the caller should persist, the resulting Classification, and the
+// Action that produced it. A drop Action returns an empty string, and a
+// hash Action returns a hex-encoded SHA-256 digest rather than the
+// original value. When name matches rules for more than one
+// Classification (e.g. "user.*" and "*.body" both matching "user.body"),
+// the most restrictive Action bound to any of the constituent
+// Classification flags is used, not just an exact binding for the
+// combined value - see dataclassifier.Enforcer.
+func (p *Policy) Apply(name, value string) (newValue string, c dataclassifier.Classification, action Action) {
+	c = p.set.Classify(name)
+	if c == dataclassifier.NoValue {
+		c = p.def
+	}
+
+	newValue, enforced := p.enforcer.Enforce(c, enforcerScope, value)
+	return newValue, c, fromEnforcerAction[enforced]
+}
@@ -0,0 +1,167 @@
+package policy_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dataclassifier "github.com/MovieStoreGuy/data-classifier"
+	"github.com/MovieStoreGuy/data-classifier/policy"
+)
+
+const document = `{
+	"default": "no-value",
+	"rules": [
+		{"pattern": "user.*", "classification": "pii"},
+		{"pattern": "*.body", "classification": "ugc"},
+		{"pattern": "req.headers.authorization", "classification": "sensitive"}
+	],
+	"actions": {
+		"pii": "hash",
+		"ugc": "drop",
+		"sensitive": "redact"
+	}
+}`
+
+func TestLoadAndApply(t *testing.T) {
+	t.Parallel()
+
+	p, err := policy.Load(strings.NewReader(document))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Scenario     string
+		Name         string
+		Value        string
+		ExpectClass  dataclassifier.Classification
+		ExpectAction policy.Action
+		ExpectValue  string
+	}{
+		{
+			Scenario:     "PII field is hashed",
+			Name:         "user.email",
+			Value:        "example-email@example.com",
+			ExpectClass:  dataclassifier.PII,
+			ExpectAction: policy.ActionHash,
+		},
+		{
+			Scenario:     "UGC field is dropped",
+			Name:         "message.body",
+			Value:        "pineapples belong on pizza",
+			ExpectClass:  dataclassifier.UGC,
+			ExpectAction: policy.ActionDrop,
+			ExpectValue:  "",
+		},
+		{
+			Scenario:     "Sensitive field is redacted",
+			Name:         "req.headers.authorization",
+			Value:        "Bearer token",
+			ExpectClass:  dataclassifier.Sensitive,
+			ExpectAction: policy.ActionRedact,
+			ExpectValue:  "[REDACTED]",
+		},
+		{
+			Scenario:     "Unmatched field falls back to the default",
+			Name:         "timestamp",
+			Value:        "2026-07-29",
+			ExpectClass:  dataclassifier.NoValue,
+			ExpectAction: policy.ActionPersist,
+			ExpectValue:  "2026-07-29",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Scenario, func(t *testing.T) {
+			value, class, action := p.Apply(tc.Name, tc.Value)
+			assert.Equal(t, tc.ExpectClass, class)
+			assert.Equal(t, tc.ExpectAction, action)
+			if tc.ExpectAction == policy.ActionHash {
+				assert.NotEqual(t, tc.Value, value, "hashed value must not equal the original")
+				assert.NotEmpty(t, value)
+				return
+			}
+			assert.Equal(t, tc.ExpectValue, value)
+		})
+	}
+}
+
+func TestApplyResolvesOverlappingRulesToMostRestrictiveAction(t *testing.T) {
+	t.Parallel()
+
+	const doc = `{
+		"rules": [
+			{"pattern": "user.*", "classification": "pii"},
+			{"pattern": "*.body", "classification": "ugc"}
+		],
+		"actions": {
+			"pii": "drop",
+			"ugc": "drop"
+		}
+	}`
+
+	p, err := policy.Load(strings.NewReader(doc))
+	require.NoError(t, err)
+
+	value, class, action := p.Apply("user.body", "Jane's free-form note")
+	assert.Equal(t, dataclassifier.Combine(dataclassifier.PII, dataclassifier.UGC), class)
+	assert.Equal(t, policy.ActionDrop, action)
+	assert.Empty(t, value)
+}
+
+func TestApplyPrefersRedactOverHashOnOverlap(t *testing.T) {
+	t.Parallel()
+
+	const doc = `{
+		"rules": [
+			{"pattern": "user.*", "classification": "pii"},
+			{"pattern": "user.ssn", "classification": "sensitive"}
+		],
+		"actions": {
+			"pii": "hash",
+			"sensitive": "redact"
+		}
+	}`
+
+	p, err := policy.Load(strings.NewReader(doc))
+	require.NoError(t, err)
+
+	value, class, action := p.Apply("user.ssn", "123-45-6789")
+	assert.Equal(t, dataclassifier.Combine(dataclassifier.PII, dataclassifier.Sensitive), class)
+	assert.Equal(t, policy.ActionRedact, action)
+	assert.Equal(t, "[REDACTED]", value)
+}
+
+func TestLoadRejectsUnknownClassification(t *testing.T) {
+	t.Parallel()
+
+	_, err := policy.Load(strings.NewReader(`{"rules":[{"pattern":"user.*","classification":"not-a-real-class"}]}`))
+	assert.Error(t, err)
+}
+
+func TestLoadRejectsUnknownAction(t *testing.T) {
+	t.Parallel()
+
+	_, err := policy.Load(strings.NewReader(`{"actions":{"pii":"delete"}}`))
+	assert.Error(t, err)
+}
+
+func TestLoadYAML(t *testing.T) {
+	t.Parallel()
+
+	const doc = `
+default: no-value
+rules:
+  - pattern: "user.*"
+    classification: pii
+actions:
+  pii: hash
+`
+	p, err := policy.LoadYAML(strings.NewReader(doc))
+	require.NoError(t, err)
+
+	_, class, action := p.Apply("user.name", "Jane Doe")
+	assert.Equal(t, dataclassifier.PII, class)
+	assert.Equal(t, policy.ActionHash, action)
+}
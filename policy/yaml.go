@@ -0,0 +1,54 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v2"
+)
+
+// LoadYAML parses a YAML document into a Policy. Like blubber's
+// ghodss/yaml trick, it decodes once into generic interface{} values and
+// round-trips them through encoding/json rather than duplicating Load's
+// validation for a second format.
+func LoadYAML(r io.Reader) (*Policy, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("policy: read yaml: %w", err)
+	}
+
+	var generic interface{}
+	if err := yaml.Unmarshal(raw, &generic); err != nil {
+		return nil, fmt.Errorf("policy: decode yaml: %w", err)
+	}
+
+	converted, err := json.Marshal(jsonify(generic))
+	if err != nil {
+		return nil, fmt.Errorf("policy: convert yaml to json: %w", err)
+	}
+
+	return Load(bytes.NewReader(converted))
+}
+
+// jsonify recursively converts the map[interface{}]interface{} values that
+// gopkg.in/yaml.v2 produces into map[string]interface{}, which
+// encoding/json is able to marshal.
+func jsonify(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprintf("%v", key)] = jsonify(val)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = jsonify(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
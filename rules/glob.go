@@ -0,0 +1,28 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+)
+
+// compileGlob translates a shell-style glob pattern (only `*`, matching any
+// run of characters, and `?`, matching exactly one) into an anchored
+// regular expression. Compiling once up front, rather than re-evaluating a
+// pattern string against every attribute, is what lets a Set scale to
+// thousands of rules.
+func compileGlob(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteByte('^')
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteByte('$')
+	return regexp.Compile(sb.String())
+}
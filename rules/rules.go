@@ -0,0 +1,108 @@
+// Package rules lets callers declaratively map attribute name patterns
+// (glob-style, e.g. "user.*") to a dataclassifier.Classification and
+// compiles them into matchers that can be evaluated cheaply across large
+// numbers of attributes and rules.
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	dataclassifier "github.com/MovieStoreGuy/data-classifier"
+)
+
+type entry struct {
+	pattern string
+	class   dataclassifier.Classification
+	literal bool
+	matcher *regexp.Regexp
+}
+
+// Set holds a collection of glob pattern -> Classification rules. Rules are
+// matched against an attribute name and every match is OR-combined
+// (dataclassifier.Combine) into the result.
+type Set struct {
+	entries  []entry
+	literals map[string]dataclassifier.Classification
+	globs    []entry
+	dirty    bool
+}
+
+// NewSet returns an empty, ready to use Set.
+func NewSet() *Set {
+	return &Set{}
+}
+
+// isLiteral reports whether pattern contains no glob metacharacters, and so
+// can be matched by an exact name lookup instead of a compiled regexp.
+func isLiteral(pattern string) bool {
+	return !strings.ContainsAny(pattern, "*?")
+}
+
+// Add registers a glob pattern against a Classification. A pattern with no
+// `*` or `?` is treated as a literal attribute name and indexed for
+// constant-time lookup; only patterns containing glob metacharacters are
+// compiled to a regexp.
+func (s *Set) Add(pattern string, c dataclassifier.Classification) error {
+	if pattern == "" {
+		return fmt.Errorf("rules: pattern must not be empty")
+	}
+	e := entry{pattern: pattern, class: c, literal: isLiteral(pattern)}
+	if !e.literal {
+		matcher, err := compileGlob(pattern)
+		if err != nil {
+			return fmt.Errorf("rules: invalid pattern %q: %w", pattern, err)
+		}
+		e.matcher = matcher
+	}
+	s.entries = append(s.entries, e)
+	s.dirty = true
+	return nil
+}
+
+// Compile builds the literal-name index and the glob matcher list from
+// every rule added so far. Calling it ahead of time avoids paying that cost
+// on the first Classify call.
+func (s *Set) Compile() error {
+	literals := make(map[string]dataclassifier.Classification, len(s.entries))
+	globs := make([]entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		if e.literal {
+			literals[e.pattern] = dataclassifier.Combine(literals[e.pattern], e.class)
+			continue
+		}
+		globs = append(globs, e)
+	}
+	s.literals = literals
+	s.globs = globs
+	s.dirty = false
+	return nil
+}
+
+// Classify returns the OR-combined Classification of every rule whose
+// pattern matches name. Literal patterns are resolved through a name ->
+// Classification index in O(1); only patterns containing `*` or `?` fall
+// back to a linear scan of their compiled regexps, so a Set dominated by
+// literal rules does not pay per-attribute regex cost as it grows to
+// thousands of entries. It returns dataclassifier.NoValue when no rule
+// matches.
+func (s *Set) Classify(name string) dataclassifier.Classification {
+	if s.dirty {
+		// Add validates patterns eagerly, so compilation at this point
+		// cannot fail.
+		_ = s.Compile()
+	}
+	result := s.literals[name]
+	for _, e := range s.globs {
+		if e.matcher.MatchString(name) {
+			result = dataclassifier.Combine(result, e.class)
+		}
+	}
+	return result
+}
+
+// Len returns the number of rules registered in the Set.
+func (s *Set) Len() int {
+	return len(s.entries)
+}
@@ -0,0 +1,89 @@
+package rules_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dataclassifier "github.com/MovieStoreGuy/data-classifier"
+	"github.com/MovieStoreGuy/data-classifier/rules"
+)
+
+func TestSetClassify(t *testing.T) {
+	t.Parallel()
+
+	set := rules.NewSet()
+	require.NoError(t, set.Add("user.*", dataclassifier.PII))
+	require.NoError(t, set.Add("*.body", dataclassifier.UGC))
+	require.NoError(t, set.Add("req.headers.authorization", dataclassifier.Sensitive))
+
+	testCases := []struct {
+		Scenario string
+		Name     string
+		Expect   dataclassifier.Classification
+	}{
+		{"Matches a prefix glob", "user.name", dataclassifier.PII},
+		{"Matches a suffix glob", "message.body", dataclassifier.UGC},
+		{"Matches an exact pattern", "req.headers.authorization", dataclassifier.Sensitive},
+		{"No rule matches", "timestamp", dataclassifier.NoValue},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Scenario, func(t *testing.T) {
+			assert.Equal(t, tc.Expect, set.Classify(tc.Name))
+		})
+	}
+}
+
+func TestSetCompileIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	set := rules.NewSet()
+	require.NoError(t, set.Add("user.*", dataclassifier.PII))
+	require.NoError(t, set.Compile())
+	require.NoError(t, set.Compile())
+
+	assert.Equal(t, dataclassifier.PII, set.Classify("user.email"))
+	assert.Equal(t, 1, set.Len())
+}
+
+func TestSetAddRejectsEmptyPattern(t *testing.T) {
+	t.Parallel()
+
+	set := rules.NewSet()
+	assert.Error(t, set.Add("", dataclassifier.PII))
+}
+
+func TestSetCombinesOverlappingRules(t *testing.T) {
+	t.Parallel()
+
+	set := rules.NewSet()
+	require.NoError(t, set.Add("user.*", dataclassifier.PII))
+	require.NoError(t, set.Add("user.notes", dataclassifier.UGC))
+
+	assert.Equal(t, dataclassifier.Combine(dataclassifier.PII, dataclassifier.UGC), set.Classify("user.notes"))
+}
+
+var cached dataclassifier.Classification
+
+// BenchmarkSetClassifyLiterals shows that a Set of literal attribute-name
+// rules is classified through the name index, so cost does not grow
+// linearly with the rule count the way a per-attribute regex scan would.
+func BenchmarkSetClassifyLiterals(b *testing.B) {
+	for _, size := range []int{10, 100, 1_000, 10_000} {
+		set := rules.NewSet()
+		for i := 0; i < size; i++ {
+			require.NoError(b, set.Add(fmt.Sprintf("attribute.%d", i), dataclassifier.PII))
+		}
+		require.NoError(b, set.Compile())
+
+		b.Run(fmt.Sprintf("Rules-%d", size), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cached = set.Classify("attribute.not-present")
+			}
+		})
+	}
+}